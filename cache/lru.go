@@ -0,0 +1,140 @@
+// Package cache provides a capacity-bounded, concurrency-friendly cache
+// used in place of an unbounded map guarded by a single mutex.
+package cache
+
+import (
+	"container/list"
+	"hash/fnv"
+	"sync"
+)
+
+const numShards = 16
+
+// Cache is a capacity-bounded key/value store that evicts least-recently
+// used entries on insert once a shard is full.
+type Cache[V any] interface {
+	Get(key string) (V, bool)
+	Set(key string, value V)
+	Delete(key string)
+	Len() int
+}
+
+type entry[V any] struct {
+	key   string
+	value V
+}
+
+type shard[V any] struct {
+	mu       sync.Mutex
+	capacity int
+	ll       *list.List
+	items    map[string]*list.Element
+}
+
+func newShard[V any](capacity int) *shard[V] {
+	return &shard[V]{
+		capacity: capacity,
+		ll:       list.New(),
+		items:    make(map[string]*list.Element),
+	}
+}
+
+func (s *shard[V]) get(key string) (V, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	el, ok := s.items[key]
+	if !ok {
+		var zero V
+		return zero, false
+	}
+	s.ll.MoveToFront(el)
+	return el.Value.(*entry[V]).value, true
+}
+
+func (s *shard[V]) set(key string, value V) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if el, ok := s.items[key]; ok {
+		el.Value.(*entry[V]).value = value
+		s.ll.MoveToFront(el)
+		return
+	}
+
+	el := s.ll.PushFront(&entry[V]{key: key, value: value})
+	s.items[key] = el
+
+	if s.ll.Len() > s.capacity {
+		oldest := s.ll.Back()
+		if oldest != nil {
+			s.ll.Remove(oldest)
+			delete(s.items, oldest.Value.(*entry[V]).key)
+		}
+	}
+}
+
+func (s *shard[V]) delete(key string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if el, ok := s.items[key]; ok {
+		s.ll.Remove(el)
+		delete(s.items, key)
+	}
+}
+
+func (s *shard[V]) len() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.ll.Len()
+}
+
+// ShardedLRU is a Cache implementation split into fixed shards, each with
+// its own lock and LRU list, to reduce contention under concurrent load.
+// Capacity is enforced per shard on insert, so there is no need for a
+// separate periodic eviction sweep.
+type ShardedLRU[V any] struct {
+	shards [numShards]*shard[V]
+}
+
+// NewShardedLRU returns a ShardedLRU where each of the 16 shards holds up
+// to capacityPerShard entries.
+func NewShardedLRU[V any](capacityPerShard int) *ShardedLRU[V] {
+	c := &ShardedLRU[V]{}
+	for i := range c.shards {
+		c.shards[i] = newShard[V](capacityPerShard)
+	}
+	return c
+}
+
+func (c *ShardedLRU[V]) shardFor(key string) *shard[V] {
+	h := fnv.New32a()
+	h.Write([]byte(key))
+	return c.shards[h.Sum32()%numShards]
+}
+
+// Get returns the cached value for key, promoting it to most-recently-used.
+func (c *ShardedLRU[V]) Get(key string) (V, bool) {
+	return c.shardFor(key).get(key)
+}
+
+// Set inserts or updates key, evicting the shard's least-recently-used
+// entry if it is now over capacity.
+func (c *ShardedLRU[V]) Set(key string, value V) {
+	c.shardFor(key).set(key, value)
+}
+
+// Delete removes key, if present.
+func (c *ShardedLRU[V]) Delete(key string) {
+	c.shardFor(key).delete(key)
+}
+
+// Len returns the total number of entries across all shards.
+func (c *ShardedLRU[V]) Len() int {
+	total := 0
+	for _, s := range c.shards {
+		total += s.len()
+	}
+	return total
+}
@@ -0,0 +1,40 @@
+package cache
+
+import "testing"
+
+func TestShardedLRUGetSet(t *testing.T) {
+	c := NewShardedLRU[int](2)
+
+	c.Set("a", 1)
+	if v, ok := c.Get("a"); !ok || v != 1 {
+		t.Fatalf("expected a=1, got %v, %v", v, ok)
+	}
+
+	if _, ok := c.Get("missing"); ok {
+		t.Fatal("expected miss for unset key")
+	}
+}
+
+func TestShardedLRUEvictsOnInsert(t *testing.T) {
+	c := NewShardedLRU[int](2)
+
+	// Force everything into the same shard by overriding via direct shard
+	// access isn't exposed, so instead fill well past capacity across all
+	// shards and assert total length stays bounded.
+	for i := 0; i < 1000; i++ {
+		c.Set(string(rune('a'+i%26))+string(rune(i)), i)
+	}
+
+	if c.Len() > numShards*2 {
+		t.Fatalf("expected capacity to be enforced per shard, got %d entries", c.Len())
+	}
+}
+
+func TestShardedLRUDelete(t *testing.T) {
+	c := NewShardedLRU[int](4)
+	c.Set("a", 1)
+	c.Delete("a")
+	if _, ok := c.Get("a"); ok {
+		t.Fatal("expected key to be gone after Delete")
+	}
+}
@@ -0,0 +1,86 @@
+// Package grpc exposes the Weather service over gRPC, backed by the same
+// weather.Service used by the HTTP transport in main.go.
+package grpc
+
+import (
+	"context"
+	"fmt"
+
+	"weather-app-jack-henry/grpc/weatherpb"
+	"weather-app-jack-henry/weather"
+)
+
+// Server implements weatherpb.WeatherServer on top of a shared
+// weather.Service.
+type Server struct {
+	weatherpb.UnimplementedWeatherServer
+	svc *weather.Service
+}
+
+// NewServer returns a Server that serves requests via svc.
+func NewServer(svc *weather.Service) *Server {
+	return &Server{svc: svc}
+}
+
+// Current returns today's forecast period, converted to the requested
+// units.
+func (s *Server) Current(ctx context.Context, req *weatherpb.CurrentRequest) (*weatherpb.Period, error) {
+	resp, err := s.svc.Forecast(ctx, req.Lat, req.Lon)
+	if err != nil {
+		return nil, err
+	}
+	if len(resp.Properties.Periods) == 0 {
+		return nil, fmt.Errorf("no forecast periods available")
+	}
+	return toPeriod(resp.Properties.Periods[0], toUnits(req.Units)), nil
+}
+
+// Forecast returns up to req.Days forecast periods, converted to the
+// requested units.
+func (s *Server) Forecast(ctx context.Context, req *weatherpb.ForecastRequest) (*weatherpb.ForecastReply, error) {
+	resp, err := s.svc.Forecast(ctx, req.Lat, req.Lon)
+	if err != nil {
+		return nil, err
+	}
+
+	days := int(req.Days)
+	if days <= 0 {
+		days = 1
+	}
+	if days > 7 {
+		days = 7
+	}
+	if days > len(resp.Properties.Periods) {
+		days = len(resp.Properties.Periods)
+	}
+
+	units := toUnits(req.Units)
+	periods := make([]*weatherpb.Period, 0, days)
+	for _, p := range resp.Properties.Periods[:days] {
+		periods = append(periods, toPeriod(p, units))
+	}
+
+	return &weatherpb.ForecastReply{Periods: periods}, nil
+}
+
+func toUnits(u weatherpb.Units) weather.Units {
+	switch u {
+	case weatherpb.Units_METRIC:
+		return weather.UnitsMetric
+	case weatherpb.Units_STANDARD:
+		return weather.UnitsStandard
+	default:
+		return weather.UnitsImperial
+	}
+}
+
+func toPeriod(p weather.Period, units weather.Units) *weatherpb.Period {
+	temp := weather.ConvertFahrenheit(p.Temperature, units)
+	return &weatherpb.Period{
+		Name:               p.Name,
+		ShortForecast:      p.ShortForecast,
+		Temperature:        int32(temp),
+		TemperatureUnit:    weather.UnitSymbol(units),
+		WeatherDescription: weather.CharacterizeTemperature(p.Temperature),
+	}
+}
@@ -0,0 +1,98 @@
+package grpc
+
+import (
+	"context"
+	"log/slog"
+	"testing"
+	"time"
+
+	"weather-app-jack-henry/grpc/weatherpb"
+	"weather-app-jack-henry/weather"
+)
+
+// fakeProvider is a weather.Provider test double that always returns resp.
+type fakeProvider struct {
+	resp weather.ForecastResponse
+	err  error
+}
+
+func (p *fakeProvider) Fetch(ctx context.Context, lat, lon float64) (weather.ForecastResponse, error) {
+	return p.resp, p.err
+}
+func (p *fakeProvider) Name() string                   { return "fake" }
+func (p *fakeProvider) Ping(ctx context.Context) error { return nil }
+
+func newTestServer(periods ...weather.Period) *Server {
+	resp := weather.ForecastResponse{}
+	resp.Properties.Periods = periods
+	svc := weather.NewService(&fakeProvider{resp: resp}, time.Hour, nil, slog.Default())
+	return NewServer(svc)
+}
+
+func TestServerCurrentConvertsUnits(t *testing.T) {
+	s := newTestServer(weather.Period{Name: "Tonight", Temperature: 68, ShortForecast: "Clear"})
+
+	period, err := s.Current(context.Background(), &weatherpb.CurrentRequest{Lat: 1, Lon: 2, Units: weatherpb.Units_METRIC})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	wantC := weather.ConvertFahrenheit(68, weather.UnitsMetric)
+	if int(period.Temperature) != wantC {
+		t.Fatalf("expected temperature converted to Celsius (%d), got %d", wantC, period.Temperature)
+	}
+	if period.TemperatureUnit != "C" {
+		t.Fatalf("expected unit symbol C, got %q", period.TemperatureUnit)
+	}
+}
+
+func TestServerCurrentNoPeriodsErrors(t *testing.T) {
+	s := newTestServer()
+
+	if _, err := s.Current(context.Background(), &weatherpb.CurrentRequest{Lat: 1, Lon: 2}); err == nil {
+		t.Fatal("expected an error when the forecast has no periods")
+	}
+}
+
+func TestServerForecastClampsDays(t *testing.T) {
+	s := newTestServer(
+		weather.Period{Name: "Day1"},
+		weather.Period{Name: "Day2"},
+		weather.Period{Name: "Day3"},
+	)
+
+	reply, err := s.Forecast(context.Background(), &weatherpb.ForecastRequest{Lat: 1, Lon: 2, Days: 10})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(reply.Periods) != 3 {
+		t.Fatalf("expected days to be clamped to the number of available periods (3), got %d", len(reply.Periods))
+	}
+}
+
+func TestServerForecastDefaultsDaysToOne(t *testing.T) {
+	s := newTestServer(weather.Period{Name: "Day1"}, weather.Period{Name: "Day2"})
+
+	reply, err := s.Forecast(context.Background(), &weatherpb.ForecastRequest{Lat: 1, Lon: 2, Days: 0})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(reply.Periods) != 1 {
+		t.Fatalf("expected a non-positive Days to default to 1 period, got %d", len(reply.Periods))
+	}
+}
+
+func TestServerForecastClampsToSevenDayMax(t *testing.T) {
+	periods := make([]weather.Period, 10)
+	for i := range periods {
+		periods[i] = weather.Period{Name: "Day"}
+	}
+	s := newTestServer(periods...)
+
+	reply, err := s.Forecast(context.Background(), &weatherpb.ForecastRequest{Lat: 1, Lon: 2, Days: 9})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(reply.Periods) != 7 {
+		t.Fatalf("expected Days to be clamped to 7, got %d", len(reply.Periods))
+	}
+}
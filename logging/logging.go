@@ -0,0 +1,57 @@
+// Package logging configures the application's structured logger and
+// propagates a per-request ID through context so log entries from
+// different functions for the same request can be correlated.
+package logging
+
+import (
+	"context"
+	"log/slog"
+	"os"
+	"strings"
+)
+
+type contextKey int
+
+const requestIDKey contextKey = iota
+
+// NewLogger builds a slog.Logger whose level and format are controlled by
+// the LOG_LEVEL (debug/info/warn/error, default info) and LOG_FORMAT
+// (json/text, default json) environment variables.
+func NewLogger() *slog.Logger {
+	opts := &slog.HandlerOptions{Level: parseLevel(os.Getenv("LOG_LEVEL"))}
+
+	var handler slog.Handler
+	if strings.EqualFold(os.Getenv("LOG_FORMAT"), "text") {
+		handler = slog.NewTextHandler(os.Stdout, opts)
+	} else {
+		handler = slog.NewJSONHandler(os.Stdout, opts)
+	}
+
+	return slog.New(handler)
+}
+
+func parseLevel(s string) slog.Level {
+	switch strings.ToLower(s) {
+	case "debug":
+		return slog.LevelDebug
+	case "warn":
+		return slog.LevelWarn
+	case "error":
+		return slog.LevelError
+	default:
+		return slog.LevelInfo
+	}
+}
+
+// WithRequestID returns a context carrying requestID, retrievable via
+// RequestIDFromContext.
+func WithRequestID(ctx context.Context, requestID string) context.Context {
+	return context.WithValue(ctx, requestIDKey, requestID)
+}
+
+// RequestIDFromContext returns the request ID stored in ctx, or "" if
+// none is present.
+func RequestIDFromContext(ctx context.Context) string {
+	id, _ := ctx.Value(requestIDKey).(string)
+	return id
+}
@@ -1,46 +1,53 @@
 package main
 
 import (
+	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
-	"log"
+	"net"
 	"net/http"
 	"os"
 	"strconv"
-	"sync"
 	"time"
 
 	"github.com/gorilla/mux"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+	"google.golang.org/grpc"
+
+	weathergrpc "weather-app-jack-henry/grpc"
+	"weather-app-jack-henry/grpc/weatherpb"
+	"weather-app-jack-henry/logging"
+	"weather-app-jack-henry/middleware"
+	"weather-app-jack-henry/weather"
 )
 
 const (
-	apiURL           = "https://api.weather.gov/points"
-	cacheDuration    = 30 * time.Minute // Adjust cache duration as needed
-	evictionInterval = 1 * time.Minute  // Interval at which cache is checked for eviction
+	cacheDuration   = 30 * time.Minute // Adjust cache duration as needed
+	defaultMaxStale = 24 * time.Hour   // How long a disk cache entry may serve stale-while-revalidate
 )
 
-// ForecastResponse represents the structure of the weather data returned by the National Weather Service API
-type ForecastResponse struct {
-	Properties struct {
-		Periods []struct {
-			Name            string `json:"name"`
-			Temperature     int    `json:"temperature"`
-			TemperatureUnit string `json:"temperatureUnit"`
-			ShortForecast   string `json:"shortForecast"`
-		} `json:"periods"`
-	} `json:"properties"`
-}
+var logger = logging.NewLogger()
+var svc = newService()
+var geocoder = weather.NewGeocoder()
 
-// CacheEntry represents a cache entry with weather data and a timestamp
-type CacheEntry struct {
-	data      ForecastResponse
-	timestamp time.Time
-}
+// newService builds the weather.Service from the configured provider
+// chain and, if WEATHER_CACHE_LOCATION is set, a persistent disk cache
+// tier that lets cached forecasts survive restarts.
+func newService() *weather.Service {
+	cfg, err := weather.LoadConfig(os.Getenv("WEATHER_CONFIG_FILE"))
+	if err != nil {
+		logger.Error("failed to load weather provider config", "error", err)
+		os.Exit(1)
+	}
 
-var (
-	cache = make(map[string]CacheEntry)
-	mu    sync.RWMutex
-)
+	var disk *weather.DiskCache
+	if dir := os.Getenv("WEATHER_CACHE_LOCATION"); dir != "" {
+		disk = weather.NewDiskCache(dir, defaultMaxStale)
+	}
+
+	return weather.NewService(cfg.BuildProviderChain(logger), cacheDuration, disk, logger)
+}
 
 // isValidLatitude checks if the given latitude is valid
 func isValidLatitude(lat float64) bool {
@@ -74,76 +81,20 @@ func getWeather(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	key := fmt.Sprintf("%s:%s", latStr, lonStr)
-
-	// Check cache
-	mu.RLock()
-	entry, exists := cache[key]
-	mu.RUnlock()
-
-	if exists && time.Since(entry.timestamp) < cacheDuration {
-		fmt.Println("cache exists")
-		respondWithWeather(w, entry.data)
-		return
-	}
-
-	// Fetch data from National Weather Service API
-	forecastURL, err := getForecastURL(lat, lon)
+	forecastResponse, err := svc.Forecast(r.Context(), lat, lon)
 	if err != nil {
-		http.Error(w, "Failed to get forecast URL", http.StatusInternalServerError)
-		return
-	}
-
-	resp, err := http.Get(forecastURL)
-	if err != nil || resp.StatusCode != http.StatusOK {
 		http.Error(w, "Failed to get weather data", http.StatusInternalServerError)
 		return
 	}
-	defer resp.Body.Close()
-
-	var forecastResponse ForecastResponse
-	if err := json.NewDecoder(resp.Body).Decode(&forecastResponse); err != nil {
-		http.Error(w, "Failed to parse weather data", http.StatusInternalServerError)
-		return
-	}
-
-	// Cache the response
-	mu.Lock()
-	cache[key] = CacheEntry{
-		data:      forecastResponse,
-		timestamp: time.Now(),
-	}
-	mu.Unlock()
 
 	respondWithWeather(w, forecastResponse)
 }
 
-// getForecastURL retrieves the forecast URL for the provided latitude and longitude
-func getForecastURL(lat, lon float64) (string, error) {
-	url := fmt.Sprintf("%s/%f,%f", apiURL, lat, lon)
-	resp, err := http.Get(url)
-	if err != nil || resp.StatusCode != http.StatusOK {
-		return "", fmt.Errorf("failed to get forecast URL")
-	}
-	defer resp.Body.Close()
-
-	var result struct {
-		Properties struct {
-			Forecast string `json:"forecast"`
-		} `json:"properties"`
-	}
-	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
-		return "", fmt.Errorf("failed to parse forecast URL")
-	}
-
-	return result.Properties.Forecast, nil
-}
-
 // respondWithWeather sends the weather data as a JSON response
-func respondWithWeather(w http.ResponseWriter, forecastResponse ForecastResponse) {
+func respondWithWeather(w http.ResponseWriter, forecastResponse weather.ForecastResponse) {
 	todayForecast := forecastResponse.Properties.Periods[0]
 
-	temperatureDescription := characterizeTemperature(todayForecast.Temperature)
+	temperatureDescription := weather.CharacterizeTemperature(todayForecast.Temperature)
 
 	response := map[string]interface{}{
 		"shortForecast":      todayForecast.ShortForecast,
@@ -156,52 +107,107 @@ func respondWithWeather(w http.ResponseWriter, forecastResponse ForecastResponse
 	json.NewEncoder(w).Encode(response)
 }
 
-// characterizeTemperature classifies the temperature into "hot", "cold", or "moderate"
-func characterizeTemperature(temp int) string {
-	switch {
-	case temp <= 40:
-		return "cold"
-	case temp > 40 && temp <= 55:
-		return "chilly"
-	case temp > 55 && temp <= 75:
-		return "moderate"
-	case temp > 75 && temp <= 90:
-		return "hot"
-	case temp > 90:
-		return "very hot"
-	default:
-		return "unknown"
+// getWeatherByLocation handles the HTTP request to fetch weather data for
+// a city name ("q") or ZIP code ("zip") instead of raw coordinates.
+func getWeatherByLocation(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query().Get("q")
+	if query == "" {
+		query = r.URL.Query().Get("zip")
 	}
+	if query == "" {
+		http.Error(w, "Please provide a q or zip parameter", http.StatusBadRequest)
+		return
+	}
+
+	lat, lon, err := geocoder.Geocode(r.Context(), query)
+	if errors.Is(err, weather.ErrLocationNotFound) {
+		http.Error(w, "Location not found", http.StatusNotFound)
+		return
+	}
+	if err != nil {
+		http.Error(w, "Failed to geocode location", http.StatusInternalServerError)
+		return
+	}
+
+	forecastResponse, err := svc.Forecast(r.Context(), lat, lon)
+	if err != nil {
+		http.Error(w, "Failed to get weather data", http.StatusInternalServerError)
+		return
+	}
+
+	respondWithWeather(w, forecastResponse)
 }
 
-// startCacheEviction starts a goroutine to periodically evict expired cache entries
-func startCacheEviction() {
-	for {
-		time.Sleep(evictionInterval)
-		mu.Lock()
-		for key, entry := range cache {
-			if time.Since(entry.timestamp) > cacheDuration {
-				delete(cache, key)
-				fmt.Println("Cache delete")
-			}
-		}
-		mu.Unlock()
+// healthz reports the process is alive, regardless of upstream state.
+func healthz(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusOK)
+}
+
+// readyz reports whether the configured provider chain can currently reach
+// an upstream weather API, for use by a load balancer.
+func readyz(w http.ResponseWriter, r *http.Request) {
+	ctx, cancel := context.WithTimeout(r.Context(), 5*time.Second)
+	defer cancel()
+
+	if err := svc.Ready(ctx); err != nil {
+		http.Error(w, fmt.Sprintf("not ready: %v", err), http.StatusServiceUnavailable)
+		return
+	}
+	w.WriteHeader(http.StatusOK)
+}
+
+// startGRPCServer starts the gRPC Weather service on port, serving off the
+// same weather.Service as the HTTP handler.
+func startGRPCServer(port string) {
+	lis, err := net.Listen("tcp", ":"+port)
+	if err != nil {
+		logger.Error("gRPC server failed to listen", "port", port, "error", err)
+		os.Exit(1)
+	}
+
+	grpcServer := grpc.NewServer()
+	weatherpb.RegisterWeatherServer(grpcServer, weathergrpc.NewServer(svc))
+
+	logger.Info("gRPC server starting", "port", port)
+	if err := grpcServer.Serve(lis); err != nil {
+		logger.Error("gRPC server failed to start", "error", err)
+		os.Exit(1)
 	}
 }
 
 // main is the entry point of the application
 func main() {
-	go startCacheEviction()
+	grpcPort := os.Getenv("GRPC_PORT")
+	if grpcPort == "" {
+		grpcPort = "9090"
+	}
+	go startGRPCServer(grpcPort)
+
 	r := mux.NewRouter()
 	r.HandleFunc("/weather", getWeather).Methods("GET")
+	r.HandleFunc("/weather/by-location", getWeatherByLocation).Methods("GET")
+	r.HandleFunc("/healthz", healthz).Methods("GET")
+	r.HandleFunc("/readyz", readyz).Methods("GET")
+	r.Handle("/metrics", promhttp.Handler()).Methods("GET")
+
+	rateLimiter := middleware.NewRateLimiter(middleware.RateLimitConfig{
+		RequestsPerMinute: 60,
+		Burst:             30,
+		RouteOverrides: map[string]middleware.RouteLimit{
+			"/weather": {RequestsPerMinute: 20, Burst: 30},
+		},
+	})
 
 	port := os.Getenv("PORT")
 	if port == "" {
 		port = "8080"
 	}
 
-	log.Printf("Server starting on port %s", port)
-	if err := http.ListenAndServe(":"+port, r); err != nil {
-		log.Fatalf("Failed to start server: %v", err)
+	handler := middleware.RequestID(rateLimiter.Middleware(r))
+
+	logger.Info("server starting", "port", port)
+	if err := http.ListenAndServe(":"+port, handler); err != nil {
+		logger.Error("server failed to start", "error", err)
+		os.Exit(1)
 	}
 }
@@ -0,0 +1,153 @@
+// Package middleware holds cross-cutting HTTP middleware shared across
+// routes, such as rate limiting.
+package middleware
+
+import (
+	"fmt"
+	"net"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+)
+
+// RouteLimit overrides the default quota for a specific route.
+type RouteLimit struct {
+	RequestsPerMinute int
+	Burst             int
+}
+
+// RateLimitConfig configures a RateLimiter.
+type RateLimitConfig struct {
+	// RequestsPerMinute and Burst are the default quota applied to routes
+	// with no entry in RouteOverrides.
+	RequestsPerMinute int
+	Burst             int
+	// RouteOverrides maps a request path to a stricter or looser quota.
+	RouteOverrides map[string]RouteLimit
+	// EvictionInterval controls how often idle buckets are cleaned up.
+	EvictionInterval time.Duration
+}
+
+// gcraState is the GCRA bookkeeping for a single (client, route) key: the
+// theoretical arrival time of the next allowed request.
+type gcraState struct {
+	tat       time.Time
+	updatedAt time.Time
+}
+
+// RateLimiter is a GCRA (token-bucket equivalent) rate limiter keyed by
+// (client IP, route). It is safe for concurrent use.
+type RateLimiter struct {
+	mu     sync.Mutex
+	states map[string]*gcraState
+	cfg    RateLimitConfig
+}
+
+// NewRateLimiter creates a RateLimiter from cfg and starts its background
+// eviction of idle buckets.
+func NewRateLimiter(cfg RateLimitConfig) *RateLimiter {
+	if cfg.EvictionInterval == 0 {
+		cfg.EvictionInterval = 1 * time.Minute
+	}
+	rl := &RateLimiter{
+		states: make(map[string]*gcraState),
+		cfg:    cfg,
+	}
+	go rl.startEviction()
+	return rl
+}
+
+// limitFor returns the requests-per-minute and burst quota for path.
+func (rl *RateLimiter) limitFor(path string) (int, int) {
+	if override, ok := rl.cfg.RouteOverrides[path]; ok {
+		return override.RequestsPerMinute, override.Burst
+	}
+	return rl.cfg.RequestsPerMinute, rl.cfg.Burst
+}
+
+// allow applies the GCRA algorithm for key against the given quota at time
+// now, returning whether the request is allowed, how many requests remain
+// in the current burst window, and how long to wait before retrying when
+// denied.
+func (rl *RateLimiter) allow(key string, requestsPerMinute, burst int, now time.Time) (ok bool, remaining int, retryAfter time.Duration) {
+	emissionInterval := time.Minute / time.Duration(requestsPerMinute)
+	burstTolerance := time.Duration(burst-1) * emissionInterval
+
+	rl.mu.Lock()
+	defer rl.mu.Unlock()
+
+	state, exists := rl.states[key]
+	if !exists {
+		state = &gcraState{tat: now}
+		rl.states[key] = state
+	}
+
+	tat := state.tat
+	if tat.Before(now) {
+		tat = now
+	}
+	allowAt := tat.Add(-burstTolerance)
+
+	if now.Before(allowAt) {
+		state.updatedAt = now
+		return false, 0, allowAt.Sub(now)
+	}
+
+	newTAT := tat.Add(emissionInterval)
+	state.tat = newTAT
+	state.updatedAt = now
+
+	remainingWindow := (burstTolerance + emissionInterval) - newTAT.Sub(now)
+	remaining = int(remainingWindow / emissionInterval)
+	if remaining < 0 {
+		remaining = 0
+	}
+	return true, remaining, 0
+}
+
+// Middleware wraps next with rate limiting keyed by client IP and request
+// path.
+func (rl *RateLimiter) Middleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		key := fmt.Sprintf("%s:%s", clientIP(r), r.URL.Path)
+		requestsPerMinute, burst := rl.limitFor(r.URL.Path)
+
+		allowed, remaining, retryAfter := rl.allow(key, requestsPerMinute, burst, time.Now())
+		w.Header().Set("X-RateLimit-Remaining", strconv.Itoa(remaining))
+
+		if !allowed {
+			w.Header().Set("Retry-After", strconv.Itoa(int(retryAfter.Seconds()+1)))
+			http.Error(w, "rate limit exceeded", http.StatusTooManyRequests)
+			return
+		}
+
+		next.ServeHTTP(w, r)
+	})
+}
+
+// startEviction periodically removes buckets that have been idle long
+// enough that their burst allowance has fully replenished.
+func (rl *RateLimiter) startEviction() {
+	for {
+		time.Sleep(rl.cfg.EvictionInterval)
+		now := time.Now()
+		rl.mu.Lock()
+		for key, state := range rl.states {
+			if state.tat.Before(now) && now.Sub(state.updatedAt) > rl.cfg.EvictionInterval {
+				delete(rl.states, key)
+			}
+		}
+		rl.mu.Unlock()
+	}
+}
+
+// clientIP extracts the caller's IP address, stripping the port from
+// RemoteAddr.
+func clientIP(r *http.Request) string {
+	host, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		return r.RemoteAddr
+	}
+	return host
+}
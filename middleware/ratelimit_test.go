@@ -0,0 +1,95 @@
+package middleware
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestRateLimiterAllowsWithinBurst(t *testing.T) {
+	rl := NewRateLimiter(RateLimitConfig{RequestsPerMinute: 60, Burst: 5})
+
+	for i := 0; i < 5; i++ {
+		allowed, _, _ := rl.allow("client:/weather", 60, 5, time.Unix(0, 0))
+		if !allowed {
+			t.Fatalf("request %d within burst should be allowed", i)
+		}
+	}
+
+	allowed, _, retryAfter := rl.allow("client:/weather", 60, 5, time.Unix(0, 0))
+	if allowed {
+		t.Fatal("request beyond burst should be denied")
+	}
+	if retryAfter <= 0 {
+		t.Fatal("expected a positive retry-after when denied")
+	}
+}
+
+func TestRateLimiterRemainingCountsDownFromFullBurst(t *testing.T) {
+	rl := NewRateLimiter(RateLimitConfig{RequestsPerMinute: 60, Burst: 5})
+
+	want := []int{4, 3, 2, 1, 0}
+	for i, wantRemaining := range want {
+		allowed, remaining, _ := rl.allow("client:/weather", 60, 5, time.Unix(0, 0))
+		if !allowed {
+			t.Fatalf("request %d within burst should be allowed", i)
+		}
+		if remaining != wantRemaining {
+			t.Fatalf("request %d: remaining = %d, want %d", i, remaining, wantRemaining)
+		}
+	}
+}
+
+func TestRateLimiterPerRouteIsolation(t *testing.T) {
+	rl := NewRateLimiter(RateLimitConfig{RequestsPerMinute: 60, Burst: 1})
+
+	now := time.Unix(0, 0)
+	if allowed, _, _ := rl.allow("client:/weather", 60, 1, now); !allowed {
+		t.Fatal("first request to /weather should be allowed")
+	}
+	if allowed, _, _ := rl.allow("client:/other", 60, 1, now); !allowed {
+		t.Fatal("a different route for the same client should have its own quota")
+	}
+}
+
+// TestRateLimiterMiddlewareConcurrent hammers the middleware concurrently
+// and checks that no more than burst+1 requests (allowing for the single
+// refill during the run) succeed for a single client.
+func TestRateLimiterMiddlewareConcurrent(t *testing.T) {
+	const burst = 20
+	rl := NewRateLimiter(RateLimitConfig{RequestsPerMinute: 60, Burst: burst})
+
+	handler := rl.Middleware(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+
+	var allowed int64
+	var wg sync.WaitGroup
+	const concurrency = 100
+	wg.Add(concurrency)
+	for i := 0; i < concurrency; i++ {
+		go func() {
+			defer wg.Done()
+			req := httptest.NewRequest(http.MethodGet, "/weather", nil)
+			req.RemoteAddr = "203.0.113.1:12345"
+			rec := httptest.NewRecorder()
+			handler.ServeHTTP(rec, req)
+			if rec.Code == http.StatusOK {
+				atomic.AddInt64(&allowed, 1)
+			} else if rec.Code != http.StatusTooManyRequests {
+				t.Errorf("unexpected status code %d", rec.Code)
+			}
+		}()
+	}
+	wg.Wait()
+
+	if allowed > burst+1 {
+		t.Fatalf("expected at most %d allowed requests under burst, got %d", burst+1, allowed)
+	}
+	if allowed == 0 {
+		t.Fatal("expected at least some requests to be allowed")
+	}
+}
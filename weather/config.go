@@ -0,0 +1,61 @@
+package weather
+
+import (
+	"fmt"
+	"log/slog"
+	"os"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Config controls which providers are tried, and in what order.
+type Config struct {
+	Providers []string `yaml:"providers"`
+	OWMAPIKey string   `yaml:"owmApiKey"`
+}
+
+// LoadConfig builds a Config from a YAML file at configPath (if non-empty;
+// a missing or unparsable file is an error), then applies the environment
+// variables WEATHER_PROVIDERS and OWM_API_KEY, which override the file's
+// values when set. It defaults to NWS-only if nothing is configured.
+func LoadConfig(configPath string) (Config, error) {
+	cfg := Config{Providers: []string{"nws"}}
+
+	if configPath != "" {
+		data, err := os.ReadFile(configPath)
+		if err != nil {
+			return Config{}, fmt.Errorf("failed to read provider config %s: %w", configPath, err)
+		}
+		if err := yaml.Unmarshal(data, &cfg); err != nil {
+			return Config{}, fmt.Errorf("failed to parse provider config %s: %w", configPath, err)
+		}
+	}
+
+	if providers := os.Getenv("WEATHER_PROVIDERS"); providers != "" {
+		cfg.Providers = strings.Split(providers, ",")
+	}
+	if apiKey := os.Getenv("OWM_API_KEY"); apiKey != "" {
+		cfg.OWMAPIKey = apiKey
+	}
+
+	return cfg, nil
+}
+
+// BuildProviderChain constructs a ProviderChain from cfg, in the order its
+// Providers list specifies. Unknown provider names are ignored. logger is
+// used by the chain for per-provider upstream metrics and debug logs.
+func (cfg Config) BuildProviderChain(logger *slog.Logger) *ProviderChain {
+	var providers []Provider
+	for _, name := range cfg.Providers {
+		switch strings.TrimSpace(strings.ToLower(name)) {
+		case "nws":
+			providers = append(providers, NewNWSProvider())
+		case "owm":
+			providers = append(providers, NewOWMProvider(cfg.OWMAPIKey))
+		case "met":
+			providers = append(providers, NewMETProvider())
+		}
+	}
+	return NewProviderChain(logger, providers...)
+}
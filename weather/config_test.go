@@ -0,0 +1,71 @@
+package weather
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestLoadConfigDefaultsToNWS(t *testing.T) {
+	cfg, err := LoadConfig("")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(cfg.Providers) != 1 || cfg.Providers[0] != "nws" {
+		t.Fatalf("expected default provider [nws], got %v", cfg.Providers)
+	}
+}
+
+func TestLoadConfigReadsYAMLFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "weather.yaml")
+	yaml := "providers:\n  - nws\n  - owm\nowmApiKey: test-key\n"
+	if err := os.WriteFile(path, []byte(yaml), 0o644); err != nil {
+		t.Fatalf("failed to write config file: %v", err)
+	}
+
+	cfg, err := LoadConfig(path)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(cfg.Providers) != 2 || cfg.Providers[0] != "nws" || cfg.Providers[1] != "owm" {
+		t.Fatalf("unexpected providers: %v", cfg.Providers)
+	}
+	if cfg.OWMAPIKey != "test-key" {
+		t.Fatalf("expected owmApiKey to be loaded, got %q", cfg.OWMAPIKey)
+	}
+}
+
+func TestLoadConfigEnvOverridesFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "weather.yaml")
+	if err := os.WriteFile(path, []byte("providers:\n  - nws\n"), 0o644); err != nil {
+		t.Fatalf("failed to write config file: %v", err)
+	}
+
+	t.Setenv("WEATHER_PROVIDERS", "met,owm")
+	t.Setenv("OWM_API_KEY", "env-key")
+
+	cfg, err := LoadConfig(path)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(cfg.Providers) != 2 || cfg.Providers[0] != "met" || cfg.Providers[1] != "owm" {
+		t.Fatalf("expected env to override file providers, got %v", cfg.Providers)
+	}
+	if cfg.OWMAPIKey != "env-key" {
+		t.Fatalf("expected env to override file owmApiKey, got %q", cfg.OWMAPIKey)
+	}
+}
+
+func TestLoadConfigMissingFileErrors(t *testing.T) {
+	if _, err := LoadConfig(filepath.Join(t.TempDir(), "missing.yaml")); err == nil {
+		t.Fatal("expected an error for a missing config file")
+	}
+}
+
+func TestBuildProviderChainIgnoresUnknownNames(t *testing.T) {
+	cfg := Config{Providers: []string{"nws", "bogus", "met"}}
+	chain := cfg.BuildProviderChain(nil)
+	if len(chain.providers) != 2 {
+		t.Fatalf("expected unknown provider names to be skipped, got %d providers", len(chain.providers))
+	}
+}
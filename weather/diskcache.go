@@ -0,0 +1,110 @@
+package weather
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+)
+
+// diskCacheEntry is the on-disk JSON representation of a cached forecast.
+type diskCacheEntry struct {
+	Data      ForecastResponse `json:"data"`
+	Timestamp time.Time        `json:"timestamp"`
+}
+
+// DiskCache is a second-tier, file-backed cache that lets forecasts survive
+// process restarts. Each entry is stored as <dir>/<lat>_<lon>.json.
+type DiskCache struct {
+	dir      string
+	maxStale time.Duration
+}
+
+// NewDiskCache returns a DiskCache rooted at dir. Entries older than
+// maxStale (but still present on disk) are no longer eligible for
+// stale-while-revalidate serving.
+func NewDiskCache(dir string, maxStale time.Duration) *DiskCache {
+	return &DiskCache{dir: dir, maxStale: maxStale}
+}
+
+func (d *DiskCache) path(lat, lon float64) string {
+	return filepath.Join(d.dir, cacheKey(lat, lon)+".json")
+}
+
+// Load reads the cached entry for lat/lon from disk, if present.
+func (d *DiskCache) Load(lat, lon float64) (CacheEntry, bool, error) {
+	data, err := os.ReadFile(d.path(lat, lon))
+	if os.IsNotExist(err) {
+		return CacheEntry{}, false, nil
+	}
+	if err != nil {
+		return CacheEntry{}, false, fmt.Errorf("failed to read disk cache entry: %w", err)
+	}
+
+	var entry diskCacheEntry
+	if err := json.Unmarshal(data, &entry); err != nil {
+		return CacheEntry{}, false, fmt.Errorf("failed to parse disk cache entry: %w", err)
+	}
+
+	return CacheEntry{Data: entry.Data, Timestamp: entry.Timestamp}, true, nil
+}
+
+// Save writes entry to disk for lat/lon, creating the cache directory if
+// necessary.
+func (d *DiskCache) Save(lat, lon float64, entry CacheEntry) error {
+	if err := os.MkdirAll(d.dir, 0o755); err != nil {
+		return fmt.Errorf("failed to create disk cache dir: %w", err)
+	}
+
+	data, err := json.Marshal(diskCacheEntry{Data: entry.Data, Timestamp: entry.Timestamp})
+	if err != nil {
+		return fmt.Errorf("failed to marshal disk cache entry: %w", err)
+	}
+
+	if err := os.WriteFile(d.path(lat, lon), data, 0o644); err != nil {
+		return fmt.Errorf("failed to write disk cache entry: %w", err)
+	}
+	return nil
+}
+
+// IsStaleButUsable reports whether entry is older than cacheDuration but
+// still within maxStale, i.e. eligible for stale-while-revalidate serving.
+func (d *DiskCache) IsStaleButUsable(timestamp time.Time, cacheDuration time.Duration) bool {
+	age := time.Since(timestamp)
+	return age >= cacheDuration && age < d.maxStale
+}
+
+// LoadAll scans the cache directory and returns every entry it can parse,
+// keyed the same way Service keys its in-memory cache. It is used to warm
+// the in-memory cache from disk on startup.
+func (d *DiskCache) LoadAll() (map[string]CacheEntry, error) {
+	entries := make(map[string]CacheEntry)
+
+	files, err := os.ReadDir(d.dir)
+	if os.IsNotExist(err) {
+		return entries, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to list disk cache dir: %w", err)
+	}
+
+	for _, f := range files {
+		if f.IsDir() || !strings.HasSuffix(f.Name(), ".json") {
+			continue
+		}
+		data, err := os.ReadFile(filepath.Join(d.dir, f.Name()))
+		if err != nil {
+			continue
+		}
+		var entry diskCacheEntry
+		if err := json.Unmarshal(data, &entry); err != nil {
+			continue
+		}
+		key := strings.TrimSuffix(f.Name(), ".json")
+		entries[key] = CacheEntry{Data: entry.Data, Timestamp: entry.Timestamp}
+	}
+
+	return entries, nil
+}
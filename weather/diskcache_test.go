@@ -0,0 +1,117 @@
+package weather
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func TestDiskCacheSaveAndLoad(t *testing.T) {
+	d := NewDiskCache(t.TempDir(), time.Hour)
+
+	want := CacheEntry{Timestamp: time.Now()}
+	want.Data.Properties.Periods = []Period{{Name: "Tonight"}}
+
+	if err := d.Save(40.7, -74.0, want); err != nil {
+		t.Fatalf("Save failed: %v", err)
+	}
+
+	got, ok, err := d.Load(40.7, -74.0)
+	if err != nil {
+		t.Fatalf("Load failed: %v", err)
+	}
+	if !ok {
+		t.Fatal("expected entry to be found")
+	}
+	if got.Data.Properties.Periods[0].Name != "Tonight" {
+		t.Fatalf("unexpected data round-tripped: %+v", got.Data)
+	}
+}
+
+func TestDiskCacheLoadMissingEntry(t *testing.T) {
+	d := NewDiskCache(t.TempDir(), time.Hour)
+
+	_, ok, err := d.Load(1, 1)
+	if err != nil {
+		t.Fatalf("expected no error for a missing entry, got %v", err)
+	}
+	if ok {
+		t.Fatal("expected ok=false for a missing entry")
+	}
+}
+
+func TestDiskCacheSaveCreatesDir(t *testing.T) {
+	dir := filepath.Join(t.TempDir(), "nested", "cache")
+	d := NewDiskCache(dir, time.Hour)
+
+	if err := d.Save(0, 0, CacheEntry{Timestamp: time.Now()}); err != nil {
+		t.Fatalf("Save failed: %v", err)
+	}
+	if _, err := os.Stat(dir); err != nil {
+		t.Fatalf("expected cache dir to be created: %v", err)
+	}
+}
+
+func TestIsStaleButUsable(t *testing.T) {
+	d := NewDiskCache(t.TempDir(), time.Hour)
+	const cacheDuration = 10 * time.Minute
+
+	fresh := time.Now()
+	if d.IsStaleButUsable(fresh, cacheDuration) {
+		t.Fatal("a fresh entry should not be considered stale")
+	}
+
+	staleButUsable := time.Now().Add(-30 * time.Minute)
+	if !d.IsStaleButUsable(staleButUsable, cacheDuration) {
+		t.Fatal("an entry within maxStale should be usable")
+	}
+
+	tooStale := time.Now().Add(-2 * time.Hour)
+	if d.IsStaleButUsable(tooStale, cacheDuration) {
+		t.Fatal("an entry older than maxStale should not be usable")
+	}
+}
+
+func TestDiskCacheLoadAll(t *testing.T) {
+	dir := t.TempDir()
+	d := NewDiskCache(dir, time.Hour)
+
+	entryA := CacheEntry{Timestamp: time.Now()}
+	entryA.Data.Properties.Periods = []Period{{Name: "A"}}
+	entryB := CacheEntry{Timestamp: time.Now()}
+	entryB.Data.Properties.Periods = []Period{{Name: "B"}}
+
+	if err := d.Save(1, 1, entryA); err != nil {
+		t.Fatalf("Save failed: %v", err)
+	}
+	if err := d.Save(2, 2, entryB); err != nil {
+		t.Fatalf("Save failed: %v", err)
+	}
+	if err := os.WriteFile(filepath.Join(dir, "ignore.txt"), []byte("not json"), 0o644); err != nil {
+		t.Fatalf("failed to write non-cache file: %v", err)
+	}
+
+	all, err := d.LoadAll()
+	if err != nil {
+		t.Fatalf("LoadAll failed: %v", err)
+	}
+	if len(all) != 2 {
+		t.Fatalf("expected 2 entries, got %d: %v", len(all), all)
+	}
+	if entry, ok := all[cacheKey(1, 1)]; !ok || entry.Data.Properties.Periods[0].Name != "A" {
+		t.Fatalf("missing or wrong entry for (1,1): %+v", entry)
+	}
+}
+
+func TestDiskCacheLoadAllMissingDir(t *testing.T) {
+	d := NewDiskCache(filepath.Join(t.TempDir(), "does-not-exist"), time.Hour)
+
+	all, err := d.LoadAll()
+	if err != nil {
+		t.Fatalf("expected no error for a missing cache dir, got %v", err)
+	}
+	if len(all) != 0 {
+		t.Fatalf("expected no entries, got %d", len(all))
+	}
+}
@@ -0,0 +1,91 @@
+package weather
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"weather-app-jack-henry/cache"
+)
+
+const (
+	nominatimURL       = "https://nominatim.openstreetmap.org/search"
+	nominatimUserAgent = "weather-app-jack-henry/1.0 (https://github.com/Amod1996/weather-app-jack-henry)"
+	geocodeTTL         = 24 * time.Hour
+	geocodeShardCap    = 256
+)
+
+// ErrLocationNotFound is returned when Nominatim has no results for a
+// query.
+var ErrLocationNotFound = errors.New("location not found")
+
+type geocodeResult struct {
+	Lat       float64
+	Lon       float64
+	Timestamp time.Time
+}
+
+// Geocoder resolves a free-form location query (city name or ZIP code)
+// into coordinates via OpenStreetMap Nominatim, caching results for 24h
+// since city coordinates rarely change.
+type Geocoder struct {
+	cache   cache.Cache[geocodeResult]
+	baseURL string
+}
+
+// NewGeocoder returns a Geocoder backed by Nominatim.
+func NewGeocoder() *Geocoder {
+	return &Geocoder{
+		cache:   cache.NewShardedLRU[geocodeResult](geocodeShardCap),
+		baseURL: nominatimURL,
+	}
+}
+
+// Geocode resolves query (a city name, "City, ST", or ZIP code) into
+// lat/lon. It returns ErrLocationNotFound when Nominatim has no matches.
+func (g *Geocoder) Geocode(ctx context.Context, query string) (lat, lon float64, err error) {
+	key := strings.ToLower(strings.TrimSpace(query))
+
+	if entry, ok := g.cache.Get(key); ok && time.Since(entry.Timestamp) < geocodeTTL {
+		return entry.Lat, entry.Lon, nil
+	}
+
+	reqURL := fmt.Sprintf("%s?format=json&q=%s", g.baseURL, url.QueryEscape(query))
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, reqURL, nil)
+	if err != nil {
+		return 0, 0, fmt.Errorf("failed to build nominatim request: %w", err)
+	}
+	req.Header.Set("User-Agent", nominatimUserAgent)
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil || resp.StatusCode != http.StatusOK {
+		return 0, 0, fmt.Errorf("failed to geocode %q", query)
+	}
+	defer resp.Body.Close()
+
+	var results []struct {
+		Lat string `json:"lat"`
+		Lon string `json:"lon"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&results); err != nil {
+		return 0, 0, fmt.Errorf("failed to parse nominatim response: %w", err)
+	}
+	if len(results) == 0 {
+		return 0, 0, ErrLocationNotFound
+	}
+
+	if _, err := fmt.Sscanf(results[0].Lat, "%f", &lat); err != nil {
+		return 0, 0, fmt.Errorf("failed to parse latitude from nominatim: %w", err)
+	}
+	if _, err := fmt.Sscanf(results[0].Lon, "%f", &lon); err != nil {
+		return 0, 0, fmt.Errorf("failed to parse longitude from nominatim: %w", err)
+	}
+
+	g.cache.Set(key, geocodeResult{Lat: lat, Lon: lon, Timestamp: time.Now()})
+	return lat, lon, nil
+}
@@ -0,0 +1,119 @@
+package weather
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"weather-app-jack-henry/cache"
+)
+
+func newTestGeocoder(t *testing.T, handler http.HandlerFunc) *Geocoder {
+	t.Helper()
+	srv := httptest.NewServer(handler)
+	t.Cleanup(srv.Close)
+	return &Geocoder{
+		cache:   cache.NewShardedLRU[geocodeResult](geocodeShardCap),
+		baseURL: srv.URL,
+	}
+}
+
+func TestGeocodeReturnsCoordinates(t *testing.T) {
+	g := newTestGeocoder(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`[{"lat":"40.7128","lon":"-74.0060"}]`))
+	})
+
+	lat, lon, err := g.Geocode(context.Background(), "New York")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if lat != 40.7128 || lon != -74.0060 {
+		t.Fatalf("unexpected coordinates: %v, %v", lat, lon)
+	}
+}
+
+func TestGeocodeCachesResults(t *testing.T) {
+	calls := 0
+	g := newTestGeocoder(t, func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`[{"lat":"1","lon":"2"}]`))
+	})
+
+	for i := 0; i < 3; i++ {
+		if _, _, err := g.Geocode(context.Background(), "Somewhere"); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}
+	if calls != 1 {
+		t.Fatalf("expected a single upstream call due to caching, got %d", calls)
+	}
+}
+
+func TestGeocodeCacheKeyIsNormalized(t *testing.T) {
+	calls := 0
+	g := newTestGeocoder(t, func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`[{"lat":"1","lon":"2"}]`))
+	})
+
+	if _, _, err := g.Geocode(context.Background(), "  Somewhere  "); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if _, _, err := g.Geocode(context.Background(), "somewhere"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if calls != 1 {
+		t.Fatalf("expected query normalization to hit the same cache entry, got %d calls", calls)
+	}
+}
+
+func TestGeocodeNoResultsReturnsErrLocationNotFound(t *testing.T) {
+	g := newTestGeocoder(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`[]`))
+	})
+
+	_, _, err := g.Geocode(context.Background(), "Nowhere")
+	if !errors.Is(err, ErrLocationNotFound) {
+		t.Fatalf("expected ErrLocationNotFound, got %v", err)
+	}
+}
+
+func TestGeocodeUpstreamErrorStatus(t *testing.T) {
+	g := newTestGeocoder(t, func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadRequest)
+	})
+
+	_, _, err := g.Geocode(context.Background(), "Bad Query")
+	if err == nil {
+		t.Fatal("expected an error on a non-200 upstream response")
+	}
+}
+
+func TestGeocodeExpiredCacheEntryRefetches(t *testing.T) {
+	calls := 0
+	g := newTestGeocoder(t, func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`[{"lat":"1","lon":"2"}]`))
+	})
+
+	g.cache.Set("expired", geocodeResult{Lat: 9, Lon: 9, Timestamp: time.Now().Add(-48 * time.Hour)})
+
+	lat, _, err := g.Geocode(context.Background(), "expired")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if calls != 1 {
+		t.Fatalf("expected a refetch for an expired cache entry, got %d calls", calls)
+	}
+	if lat != 1 {
+		t.Fatalf("expected the refetched value to replace the expired one, got lat=%v", lat)
+	}
+}
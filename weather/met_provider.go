@@ -0,0 +1,97 @@
+package weather
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+)
+
+const metForecastURL = "https://api.met.no/weatherapi/locationforecast/2.0/compact"
+
+// metUserAgent is required by MET Norway's terms of use; requests without a
+// descriptive User-Agent are rejected.
+const metUserAgent = "weather-app-jack-henry/1.0 (https://github.com/Amod1996/weather-app-jack-henry)"
+
+// METProvider fetches forecasts from the MET Norway Locationforecast API.
+// Unlike NWS, it has global coverage.
+type METProvider struct {
+	client *http.Client
+}
+
+// NewMETProvider returns a Provider backed by api.met.no.
+func NewMETProvider() *METProvider {
+	return &METProvider{client: http.DefaultClient}
+}
+
+// Name identifies this provider for logging and metrics.
+func (p *METProvider) Name() string {
+	return "met"
+}
+
+// Ping checks that api.met.no is reachable.
+func (p *METProvider) Ping(ctx context.Context) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodHead, metForecastURL, nil)
+	if err != nil {
+		return fmt.Errorf("failed to build met ping request: %w", err)
+	}
+	req.Header.Set("User-Agent", metUserAgent)
+	resp, err := p.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("met unreachable: %w", err)
+	}
+	defer resp.Body.Close()
+	return nil
+}
+
+// Fetch retrieves the forecast for lat/lon from MET Norway and normalizes
+// the first timestep into a single-period ForecastResponse.
+func (p *METProvider) Fetch(ctx context.Context, lat, lon float64) (ForecastResponse, error) {
+	url := fmt.Sprintf("%s?lat=%f&lon=%f", metForecastURL, lat, lon)
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return ForecastResponse{}, fmt.Errorf("failed to build met request: %w", err)
+	}
+	req.Header.Set("User-Agent", metUserAgent)
+
+	resp, err := p.client.Do(req)
+	if err != nil || resp.StatusCode != http.StatusOK {
+		return ForecastResponse{}, fmt.Errorf("failed to get weather data from met")
+	}
+	defer resp.Body.Close()
+
+	var result struct {
+		Properties struct {
+			Timeseries []struct {
+				Data struct {
+					Instant struct {
+						Details struct {
+							AirTemperature float64 `json:"air_temperature"`
+						} `json:"details"`
+					} `json:"instant"`
+					Next1Hours struct {
+						Summary struct {
+							SymbolCode string `json:"symbol_code"`
+						} `json:"summary"`
+					} `json:"next_1_hours"`
+				} `json:"data"`
+			} `json:"timeseries"`
+		} `json:"properties"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		return ForecastResponse{}, fmt.Errorf("failed to parse met weather data: %w", err)
+	}
+	if len(result.Properties.Timeseries) == 0 {
+		return ForecastResponse{}, fmt.Errorf("met returned no timeseries data")
+	}
+
+	now := result.Properties.Timeseries[0].Data
+	var forecastResponse ForecastResponse
+	forecastResponse.Properties.Periods = []Period{{
+		Name:            "Current",
+		Temperature:     int(now.Instant.Details.AirTemperature*9/5 + 32),
+		TemperatureUnit: "F",
+		ShortForecast:   now.Next1Hours.Summary.SymbolCode,
+	}}
+	return forecastResponse, nil
+}
@@ -0,0 +1,28 @@
+package weather
+
+import "github.com/prometheus/client_golang/prometheus"
+
+// Prometheus metrics for the cache and upstream providers, exposed on
+// /metrics via promhttp.Handler() in main.go.
+var (
+	CacheHits = prometheus.NewCounter(prometheus.CounterOpts{
+		Name: "weather_cache_hits_total",
+		Help: "Number of forecast requests served from cache.",
+	})
+	CacheMisses = prometheus.NewCounter(prometheus.CounterOpts{
+		Name: "weather_cache_misses_total",
+		Help: "Number of forecast requests that required an upstream fetch.",
+	})
+	UpstreamLatency = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Name: "weather_upstream_latency_seconds",
+		Help: "Latency of upstream provider fetches.",
+	}, []string{"provider"})
+	UpstreamErrors = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "weather_upstream_errors_total",
+		Help: "Number of failed upstream provider fetches.",
+	}, []string{"provider"})
+)
+
+func init() {
+	prometheus.MustRegister(CacheHits, CacheMisses, UpstreamLatency, UpstreamErrors)
+}
@@ -0,0 +1,92 @@
+package weather
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+)
+
+const nwsPointsURL = "https://api.weather.gov/points"
+
+// NWSProvider fetches forecasts from the National Weather Service API. It
+// only has data for US coordinates; ProviderChain skips it otherwise.
+type NWSProvider struct{}
+
+// NewNWSProvider returns a Provider backed by api.weather.gov.
+func NewNWSProvider() *NWSProvider {
+	return &NWSProvider{}
+}
+
+// Name identifies this provider for logging and metrics.
+func (p *NWSProvider) Name() string {
+	return "nws"
+}
+
+// Ping checks that api.weather.gov is reachable.
+func (p *NWSProvider) Ping(ctx context.Context) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodHead, nwsPointsURL, nil)
+	if err != nil {
+		return fmt.Errorf("failed to build nws ping request: %w", err)
+	}
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("nws unreachable: %w", err)
+	}
+	defer resp.Body.Close()
+	return nil
+}
+
+// Fetch retrieves the forecast for lat/lon from the National Weather
+// Service API.
+func (p *NWSProvider) Fetch(ctx context.Context, lat, lon float64) (ForecastResponse, error) {
+	forecastURL, err := getForecastURL(ctx, lat, lon)
+	if err != nil {
+		return ForecastResponse{}, fmt.Errorf("failed to get forecast URL: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, forecastURL, nil)
+	if err != nil {
+		return ForecastResponse{}, fmt.Errorf("failed to build forecast request: %w", err)
+	}
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil || resp.StatusCode != http.StatusOK {
+		return ForecastResponse{}, fmt.Errorf("failed to get weather data")
+	}
+	defer resp.Body.Close()
+
+	var forecastResponse ForecastResponse
+	if err := json.NewDecoder(resp.Body).Decode(&forecastResponse); err != nil {
+		return ForecastResponse{}, fmt.Errorf("failed to parse weather data: %w", err)
+	}
+
+	return forecastResponse, nil
+}
+
+// getForecastURL retrieves the forecast URL for the provided latitude and
+// longitude.
+func getForecastURL(ctx context.Context, lat, lon float64) (string, error) {
+	pointsURL := fmt.Sprintf("%s/%f,%f", nwsPointsURL, lat, lon)
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, pointsURL, nil)
+	if err != nil {
+		return "", fmt.Errorf("failed to build forecast URL request: %w", err)
+	}
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil || resp.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("failed to get forecast URL")
+	}
+	defer resp.Body.Close()
+
+	var result struct {
+		Properties struct {
+			Forecast string `json:"forecast"`
+		} `json:"properties"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		return "", fmt.Errorf("failed to parse forecast URL")
+	}
+
+	return result.Properties.Forecast, nil
+}
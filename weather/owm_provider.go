@@ -0,0 +1,81 @@
+package weather
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+)
+
+const owmWeatherURL = "https://api.openweathermap.org/data/2.5/weather"
+
+// OWMProvider fetches current conditions from OpenWeatherMap. It requires
+// an API key (OWM_API_KEY).
+type OWMProvider struct {
+	apiKey string
+}
+
+// NewOWMProvider returns a Provider backed by OpenWeatherMap, authenticated
+// with apiKey.
+func NewOWMProvider(apiKey string) *OWMProvider {
+	return &OWMProvider{apiKey: apiKey}
+}
+
+// Name identifies this provider for logging and metrics.
+func (p *OWMProvider) Name() string {
+	return "owm"
+}
+
+// Ping checks that api.openweathermap.org is reachable.
+func (p *OWMProvider) Ping(ctx context.Context) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodHead, owmWeatherURL, nil)
+	if err != nil {
+		return fmt.Errorf("failed to build owm ping request: %w", err)
+	}
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("owm unreachable: %w", err)
+	}
+	defer resp.Body.Close()
+	return nil
+}
+
+// Fetch retrieves current conditions for lat/lon from OpenWeatherMap and
+// normalizes them into a single-period ForecastResponse.
+func (p *OWMProvider) Fetch(ctx context.Context, lat, lon float64) (ForecastResponse, error) {
+	url := fmt.Sprintf("%s?lat=%f&lon=%f&appid=%s&units=imperial", owmWeatherURL, lat, lon, p.apiKey)
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return ForecastResponse{}, fmt.Errorf("failed to build owm fetch request: %w", err)
+	}
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil || resp.StatusCode != http.StatusOK {
+		return ForecastResponse{}, fmt.Errorf("failed to get weather data from owm")
+	}
+	defer resp.Body.Close()
+
+	var result struct {
+		Weather []struct {
+			Main        string `json:"main"`
+			Description string `json:"description"`
+		} `json:"weather"`
+		Main struct {
+			Temp float64 `json:"temp"`
+		} `json:"main"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		return ForecastResponse{}, fmt.Errorf("failed to parse owm weather data: %w", err)
+	}
+	if len(result.Weather) == 0 {
+		return ForecastResponse{}, fmt.Errorf("owm returned no weather conditions")
+	}
+
+	var forecastResponse ForecastResponse
+	forecastResponse.Properties.Periods = []Period{{
+		Name:            "Current",
+		Temperature:     int(result.Main.Temp),
+		TemperatureUnit: "F",
+		ShortForecast:   result.Weather[0].Main,
+	}}
+	return forecastResponse, nil
+}
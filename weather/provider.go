@@ -0,0 +1,108 @@
+package weather
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"weather-app-jack-henry/logging"
+)
+
+// Provider fetches a forecast for a lat/lon pair from a single upstream
+// weather API, normalized into the shared ForecastResponse shape.
+type Provider interface {
+	// Fetch retrieves the forecast for lat/lon.
+	Fetch(ctx context.Context, lat, lon float64) (ForecastResponse, error)
+	// Name identifies the provider for logging and metrics.
+	Name() string
+	// Ping checks that the provider's upstream API is reachable, for use
+	// by a /readyz probe.
+	Ping(ctx context.Context) error
+}
+
+// ProviderChain tries a list of providers in order, falling back to the
+// next one when a provider errors or declines to handle the coordinates
+// (e.g. NWS only covers the US).
+type ProviderChain struct {
+	providers []Provider
+	logger    *slog.Logger
+}
+
+// NewProviderChain returns a ProviderChain that tries providers in the
+// given order, recording per-provider upstream metrics and debug logs
+// against logger. logger may be nil, in which case slog's default logger
+// is used.
+func NewProviderChain(logger *slog.Logger, providers ...Provider) *ProviderChain {
+	if logger == nil {
+		logger = slog.Default()
+	}
+	return &ProviderChain{providers: providers, logger: logger}
+}
+
+// Fetch tries each provider in order and returns the first successful
+// result. NWS is skipped for coordinates outside the US bounding boxes in
+// isUSCoordinate since it has no data to return there. Upstream latency and error metrics are
+// recorded per attempted provider, since c.Name() always reports "chain"
+// and would otherwise mask which concrete provider served or failed the
+// request.
+func (c *ProviderChain) Fetch(ctx context.Context, lat, lon float64) (ForecastResponse, error) {
+	var lastErr error
+	for _, p := range c.providers {
+		if _, ok := p.(*NWSProvider); ok && !isUSCoordinate(lat, lon) {
+			continue
+		}
+
+		start := time.Now()
+		resp, err := p.Fetch(ctx, lat, lon)
+		latency := time.Since(start)
+
+		UpstreamLatency.WithLabelValues(p.Name()).Observe(latency.Seconds())
+		c.logger.DebugContext(ctx, "upstream fetch",
+			"request_id", logging.RequestIDFromContext(ctx),
+			"provider", p.Name(),
+			"upstream_latency_ms", latency.Milliseconds(),
+		)
+
+		if err == nil {
+			return resp, nil
+		}
+		UpstreamErrors.WithLabelValues(p.Name()).Inc()
+		lastErr = fmt.Errorf("%s: %w", p.Name(), err)
+	}
+	if lastErr == nil {
+		lastErr = fmt.Errorf("no providers configured")
+	}
+	return ForecastResponse{}, fmt.Errorf("all providers failed: %w", lastErr)
+}
+
+// Name identifies this provider for logging and metrics.
+func (c *ProviderChain) Name() string {
+	return "chain"
+}
+
+// Ping reports the chain healthy if any configured provider is reachable.
+func (c *ProviderChain) Ping(ctx context.Context) error {
+	var lastErr error
+	for _, p := range c.providers {
+		if err := p.Ping(ctx); err == nil {
+			return nil
+		} else {
+			lastErr = err
+		}
+	}
+	if lastErr == nil {
+		lastErr = fmt.Errorf("no providers configured")
+	}
+	return fmt.Errorf("no provider reachable: %w", lastErr)
+}
+
+// isUSCoordinate reports whether lat/lon falls within the rough bounding
+// box NWS serves (continental US, Alaska, and Hawaii). This is a coarse
+// approximation used only to decide whether to try NWS at all.
+func isUSCoordinate(lat, lon float64) bool {
+	continental := lat >= 24.5 && lat <= 49.5 && lon >= -125 && lon <= -66.9
+	alaska := lat >= 51 && lat <= 71.5 && lon >= -180 && lon <= -129
+	hawaii := lat >= 18.9 && lat <= 22.3 && lon >= -160.3 && lon <= -154.8
+	return continental || alaska || hawaii
+}
@@ -0,0 +1,146 @@
+package weather
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
+)
+
+// fakeProvider is a Provider test double whose Fetch/Ping behavior is
+// controlled by its fields.
+type fakeProvider struct {
+	name      string
+	fetchResp ForecastResponse
+	fetchErr  error
+	pingErr   error
+	fetched   bool
+	pinged    bool
+	// onFetch, if set, is called at the start of Fetch - useful for
+	// synchronizing with a background refresh in tests.
+	onFetch func()
+}
+
+func (p *fakeProvider) Fetch(ctx context.Context, lat, lon float64) (ForecastResponse, error) {
+	p.fetched = true
+	if p.onFetch != nil {
+		p.onFetch()
+	}
+	return p.fetchResp, p.fetchErr
+}
+
+func (p *fakeProvider) Name() string { return p.name }
+
+func (p *fakeProvider) Ping(ctx context.Context) error {
+	p.pinged = true
+	return p.pingErr
+}
+
+func TestProviderChainFetchFallsBackOnError(t *testing.T) {
+	want := ForecastResponse{}
+	want.Properties.Periods = []Period{{Name: "backup"}}
+
+	failing := &fakeProvider{name: "failing", fetchErr: fmt.Errorf("boom")}
+	backup := &fakeProvider{name: "backup", fetchResp: want}
+	chain := NewProviderChain(nil, failing, backup)
+
+	got, err := chain.Fetch(context.Background(), 40.7, -74.0)
+	if err != nil {
+		t.Fatalf("expected fallback provider to succeed, got error: %v", err)
+	}
+	if !failing.fetched {
+		t.Fatal("expected the first provider to be tried")
+	}
+	if got.Properties.Periods[0].Name != "backup" {
+		t.Fatalf("expected response from backup provider, got %+v", got)
+	}
+}
+
+func TestProviderChainFetchReturnsErrorWhenAllFail(t *testing.T) {
+	a := &fakeProvider{name: "a", fetchErr: fmt.Errorf("a down")}
+	b := &fakeProvider{name: "b", fetchErr: fmt.Errorf("b down")}
+	chain := NewProviderChain(nil, a, b)
+
+	_, err := chain.Fetch(context.Background(), 40.7, -74.0)
+	if err == nil {
+		t.Fatal("expected an error when every provider fails")
+	}
+}
+
+func TestProviderChainFetchSkipsNWSOutsideUS(t *testing.T) {
+	nws := NewNWSProvider()
+	want := ForecastResponse{}
+	want.Properties.Periods = []Period{{Name: "met"}}
+	met := &fakeProvider{name: "met", fetchResp: want}
+	chain := NewProviderChain(nil, nws, met)
+
+	// Oslo, Norway is well outside NWS's US bounding box.
+	got, err := chain.Fetch(context.Background(), 59.9, 10.7)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got.Properties.Periods[0].Name != "met" {
+		t.Fatalf("expected NWS to be skipped in favor of met, got %+v", got)
+	}
+}
+
+func TestProviderChainPingReturnsNilIfAnyProviderReachable(t *testing.T) {
+	down := &fakeProvider{name: "down", pingErr: fmt.Errorf("unreachable")}
+	up := &fakeProvider{name: "up"}
+	chain := NewProviderChain(nil, down, up)
+
+	if err := chain.Ping(context.Background()); err != nil {
+		t.Fatalf("expected Ping to succeed when a provider is reachable, got %v", err)
+	}
+}
+
+func TestProviderChainPingReturnsErrorWhenNoneReachable(t *testing.T) {
+	a := &fakeProvider{name: "a", pingErr: fmt.Errorf("down")}
+	b := &fakeProvider{name: "b", pingErr: fmt.Errorf("down")}
+	chain := NewProviderChain(nil, a, b)
+
+	if err := chain.Ping(context.Background()); err == nil {
+		t.Fatal("expected an error when no provider is reachable")
+	}
+}
+
+func TestProviderChainFetchRecordsMetricsPerConcreteProvider(t *testing.T) {
+	failing := &fakeProvider{name: "test-failing", fetchErr: fmt.Errorf("boom")}
+	backup := &fakeProvider{name: "test-backup"}
+	chain := NewProviderChain(nil, failing, backup)
+
+	if _, err := chain.Fetch(context.Background(), 40.7, -74.0); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if got := testutil.ToFloat64(UpstreamErrors.WithLabelValues("test-failing")); got != 1 {
+		t.Fatalf("expected 1 error recorded for %q, got %v", failing.name, got)
+	}
+	if got := testutil.ToFloat64(UpstreamErrors.WithLabelValues("test-backup")); got != 0 {
+		t.Fatalf("expected no errors recorded for %q, got %v", backup.name, got)
+	}
+	if count := testutil.CollectAndCount(UpstreamLatency, "weather_upstream_latency_seconds"); count < 2 {
+		t.Fatalf("expected a latency observation for each attempted provider, got %d series", count)
+	}
+}
+
+func TestIsUSCoordinate(t *testing.T) {
+	cases := []struct {
+		name     string
+		lat, lon float64
+		want     bool
+	}{
+		{"continental US", 39.8, -98.5, true},
+		{"alaska", 64.2, -149.5, true},
+		{"hawaii", 21.3, -157.8, true},
+		{"norway", 59.9, 10.7, false},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := isUSCoordinate(tc.lat, tc.lon); got != tc.want {
+				t.Errorf("isUSCoordinate(%v, %v) = %v, want %v", tc.lat, tc.lon, got, tc.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,177 @@
+// Package weather holds the shared fetch/cache logic used by both the HTTP
+// and gRPC transports so they agree on what is cached and for how long.
+package weather
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"weather-app-jack-henry/cache"
+	"weather-app-jack-henry/logging"
+)
+
+// cacheShardCapacity bounds how many entries each of the sharded LRU's 16
+// shards may hold before it starts evicting the least-recently-used entry.
+const cacheShardCapacity = 1024
+
+// Period is a single forecast period as returned by the National Weather
+// Service API.
+type Period struct {
+	Name            string `json:"name"`
+	Temperature     int    `json:"temperature"`
+	TemperatureUnit string `json:"temperatureUnit"`
+	ShortForecast   string `json:"shortForecast"`
+}
+
+// ForecastResponse represents the structure of the weather data returned by
+// the National Weather Service API.
+type ForecastResponse struct {
+	Properties struct {
+		Periods []Period `json:"periods"`
+	} `json:"properties"`
+}
+
+// CacheEntry represents a cache entry with weather data and a timestamp.
+type CacheEntry struct {
+	Data      ForecastResponse
+	Timestamp time.Time
+}
+
+// cacheKey returns the map/disk key for a lat/lon pair.
+func cacheKey(lat, lon float64) string {
+	return fmt.Sprintf("%g_%g", lat, lon)
+}
+
+// Service fetches forecasts via a Provider (or ProviderChain) and caches
+// them in memory, keyed by lat/lon. It is safe for concurrent use and is
+// shared by the HTTP and gRPC servers so a cache hit on one transport serves
+// the other too. When a DiskCache is configured, it backs the in-memory
+// cache so entries survive restarts.
+type Service struct {
+	cache         cache.Cache[CacheEntry]
+	cacheDuration time.Duration
+	provider      Provider
+	disk          *DiskCache
+	logger        *slog.Logger
+}
+
+// NewService creates a Service that fetches from provider and caches
+// results for cacheDuration in a sharded LRU. disk may be nil to disable
+// the persistent cache tier. logger may be nil, in which case slog's
+// default logger is used.
+func NewService(provider Provider, cacheDuration time.Duration, disk *DiskCache, logger *slog.Logger) *Service {
+	if logger == nil {
+		logger = slog.Default()
+	}
+	s := &Service{
+		cache:         cache.NewShardedLRU[CacheEntry](cacheShardCapacity),
+		cacheDuration: cacheDuration,
+		provider:      provider,
+		disk:          disk,
+		logger:        logger,
+	}
+	if disk != nil {
+		if warm, err := disk.LoadAll(); err == nil {
+			for key, entry := range warm {
+				s.cache.Set(key, entry)
+			}
+		}
+	}
+	return s
+}
+
+// Forecast returns the cached forecast for lat/lon if it is still fresh. On
+// a memory miss it falls through to the disk tier (if configured), and
+// finally to the provider. Fresh fetches are written through to both cache
+// tiers. A disk entry that is stale but within the configured maxStale
+// window is served immediately, with a background refresh kicked off to
+// repopulate both tiers.
+func (s *Service) Forecast(ctx context.Context, lat, lon float64) (ForecastResponse, error) {
+	log := s.logger.With(
+		"request_id", logging.RequestIDFromContext(ctx),
+		"lat", lat,
+		"lon", lon,
+	)
+	key := cacheKey(lat, lon)
+
+	if entry, exists := s.cache.Get(key); exists && time.Since(entry.Timestamp) < s.cacheDuration {
+		CacheHits.Inc()
+		log.InfoContext(ctx, "served forecast", "cache_hit", true, "status", "ok")
+		return entry.Data, nil
+	}
+	CacheMisses.Inc()
+
+	if s.disk != nil {
+		if diskEntry, ok, err := s.disk.Load(lat, lon); err == nil && ok {
+			if time.Since(diskEntry.Timestamp) < s.cacheDuration {
+				s.store(lat, lon, diskEntry)
+				log.InfoContext(ctx, "served forecast", "cache_hit", true, "status", "ok", "source", "disk")
+				return diskEntry.Data, nil
+			}
+			if s.disk.IsStaleButUsable(diskEntry.Timestamp, s.cacheDuration) {
+				go s.refresh(logging.RequestIDFromContext(ctx), lat, lon)
+				log.InfoContext(ctx, "served forecast", "cache_hit", true, "status", "stale", "source", "disk")
+				return diskEntry.Data, nil
+			}
+		}
+	}
+
+	forecastResponse, err := s.fetch(ctx, lat, lon)
+	if err != nil {
+		log.ErrorContext(ctx, "failed to fetch forecast", "cache_hit", false, "status", "error", "error", err)
+		return ForecastResponse{}, err
+	}
+
+	s.store(lat, lon, CacheEntry{Data: forecastResponse, Timestamp: time.Now()})
+	log.InfoContext(ctx, "served forecast", "cache_hit", false, "status", "ok")
+	return forecastResponse, nil
+}
+
+// fetch calls the provider for a fresh forecast. Per-provider upstream
+// latency and error metrics and debug logs are recorded by
+// ProviderChain.Fetch itself, since s.provider is normally a ProviderChain
+// whose own Name() always reports "chain" and would otherwise mask which
+// concrete provider served or failed the request.
+func (s *Service) fetch(ctx context.Context, lat, lon float64) (ForecastResponse, error) {
+	return s.provider.Fetch(ctx, lat, lon)
+}
+
+// refreshTimeout bounds how long a background refresh may run after the
+// inbound request that triggered it has already returned.
+const refreshTimeout = 30 * time.Second
+
+// refresh re-fetches lat/lon from the provider and updates both cache
+// tiers. It is run in the background when serving a stale-but-usable disk
+// entry, so it must not inherit the inbound request's context: net/http and
+// grpc-go cancel that context as soon as the handler returns, which would
+// cancel the refresh before it completes. requestID is carried over only
+// for log correlation.
+func (s *Service) refresh(requestID string, lat, lon float64) {
+	ctx, cancel := context.WithTimeout(context.Background(), refreshTimeout)
+	defer cancel()
+	ctx = logging.WithRequestID(ctx, requestID)
+
+	forecastResponse, err := s.fetch(ctx, lat, lon)
+	if err != nil {
+		return
+	}
+	s.store(lat, lon, CacheEntry{Data: forecastResponse, Timestamp: time.Now()})
+}
+
+// store writes entry into the in-memory cache and, if configured, the disk
+// tier.
+func (s *Service) store(lat, lon float64, entry CacheEntry) {
+	s.cache.Set(cacheKey(lat, lon), entry)
+
+	if s.disk != nil {
+		_ = s.disk.Save(lat, lon, entry)
+	}
+}
+
+// Ready checks that the configured provider chain can reach its upstream
+// API, for use by a /readyz probe.
+func (s *Service) Ready(ctx context.Context) error {
+	return s.provider.Ping(ctx)
+}
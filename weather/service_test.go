@@ -0,0 +1,140 @@
+package weather
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"testing"
+	"time"
+)
+
+func newTestService(provider Provider, cacheDuration time.Duration, disk *DiskCache) *Service {
+	return NewService(provider, cacheDuration, disk, slog.Default())
+}
+
+func TestServiceForecastMemoryHit(t *testing.T) {
+	want := ForecastResponse{}
+	want.Properties.Periods = []Period{{Name: "cached"}}
+	provider := &fakeProvider{name: "p"}
+	svc := newTestService(provider, 10*time.Minute, nil)
+	svc.cache.Set(cacheKey(1, 2), CacheEntry{Data: want, Timestamp: time.Now()})
+
+	got, err := svc.Forecast(context.Background(), 1, 2)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if provider.fetched {
+		t.Fatal("expected the provider not to be called on a memory hit")
+	}
+	if got.Properties.Periods[0].Name != "cached" {
+		t.Fatalf("unexpected response: %+v", got)
+	}
+}
+
+func TestServiceForecastDiskWarmStart(t *testing.T) {
+	disk := NewDiskCache(t.TempDir(), time.Hour)
+	fresh := CacheEntry{Timestamp: time.Now()}
+	fresh.Data.Properties.Periods = []Period{{Name: "warm"}}
+	if err := disk.Save(10, 20, fresh); err != nil {
+		t.Fatalf("Save failed: %v", err)
+	}
+
+	provider := &fakeProvider{name: "p"}
+	svc := newTestService(provider, time.Hour, disk)
+
+	if _, ok := svc.cache.Get(cacheKey(10, 20)); !ok {
+		t.Fatal("expected NewService to warm the in-memory cache from disk on startup")
+	}
+
+	got, err := svc.Forecast(context.Background(), 10, 20)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if provider.fetched {
+		t.Fatal("expected the provider not to be called once warmed from disk")
+	}
+	if got.Properties.Periods[0].Name != "warm" {
+		t.Fatalf("unexpected response: %+v", got)
+	}
+}
+
+func TestServiceForecastStaleButUsableTriggersBackgroundRefresh(t *testing.T) {
+	const cacheDuration = 10 * time.Minute
+	disk := NewDiskCache(t.TempDir(), time.Hour)
+
+	stale := CacheEntry{Timestamp: time.Now().Add(-20 * time.Minute)}
+	stale.Data.Properties.Periods = []Period{{Name: "stale"}}
+	if err := disk.Save(5, 6, stale); err != nil {
+		t.Fatalf("Save failed: %v", err)
+	}
+
+	refreshed := make(chan struct{})
+	fresh := ForecastResponse{}
+	fresh.Properties.Periods = []Period{{Name: "refreshed"}}
+	provider := &fakeProvider{name: "p", fetchResp: fresh, onFetch: func() { close(refreshed) }}
+
+	svc := newTestService(provider, cacheDuration, disk)
+
+	got, err := svc.Forecast(context.Background(), 5, 6)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got.Properties.Periods[0].Name != "stale" {
+		t.Fatalf("expected the stale entry to be served immediately, got %+v", got)
+	}
+
+	select {
+	case <-refreshed:
+	case <-time.After(2 * time.Second):
+		t.Fatal("expected a background refresh to be kicked off")
+	}
+
+	waitForCondition(t, func() bool {
+		entry, ok := svc.cache.Get(cacheKey(5, 6))
+		return ok && entry.Data.Properties.Periods[0].Name == "refreshed"
+	})
+}
+
+func TestServiceForecastFullMissFetchesAndStores(t *testing.T) {
+	want := ForecastResponse{}
+	want.Properties.Periods = []Period{{Name: "fetched"}}
+	provider := &fakeProvider{name: "p", fetchResp: want}
+	svc := newTestService(provider, 10*time.Minute, nil)
+
+	got, err := svc.Forecast(context.Background(), 7, 8)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !provider.fetched {
+		t.Fatal("expected the provider to be called on a full cache miss")
+	}
+	if got.Properties.Periods[0].Name != "fetched" {
+		t.Fatalf("unexpected response: %+v", got)
+	}
+	if _, ok := svc.cache.Get(cacheKey(7, 8)); !ok {
+		t.Fatal("expected the fresh fetch to be stored in the in-memory cache")
+	}
+}
+
+func TestServiceForecastFetchErrorPropagates(t *testing.T) {
+	provider := &fakeProvider{name: "p", fetchErr: errors.New("upstream down")}
+	svc := newTestService(provider, 10*time.Minute, nil)
+
+	if _, err := svc.Forecast(context.Background(), 9, 9); err == nil {
+		t.Fatal("expected an error when the provider fails on a full miss")
+	}
+}
+
+// waitForCondition polls cond until it is true or fails the test after a
+// short timeout, for asserting on state updated by a background goroutine.
+func waitForCondition(t *testing.T, cond func() bool) {
+	t.Helper()
+	deadline := time.Now().Add(2 * time.Second)
+	for time.Now().Before(deadline) {
+		if cond() {
+			return
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	t.Fatal("condition not met before timeout")
+}
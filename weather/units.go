@@ -0,0 +1,59 @@
+package weather
+
+// Units identifies the unit system a caller wants temperatures returned in.
+type Units int
+
+const (
+	// UnitsImperial returns temperatures in Fahrenheit, the unit NWS
+	// responses are already expressed in.
+	UnitsImperial Units = iota
+	// UnitsMetric returns temperatures in Celsius.
+	UnitsMetric
+	// UnitsStandard returns temperatures in Kelvin.
+	UnitsStandard
+)
+
+// ConvertFahrenheit converts a Fahrenheit temperature (as returned by NWS)
+// into the requested unit system.
+func ConvertFahrenheit(fahrenheit int, units Units) int {
+	switch units {
+	case UnitsMetric:
+		return int((float64(fahrenheit) - 32) * 5 / 9)
+	case UnitsStandard:
+		celsius := (float64(fahrenheit) - 32) * 5 / 9
+		return int(celsius + 273.15)
+	default:
+		return fahrenheit
+	}
+}
+
+// UnitSymbol returns the temperature unit abbreviation used in responses for
+// the given unit system.
+func UnitSymbol(units Units) string {
+	switch units {
+	case UnitsMetric:
+		return "C"
+	case UnitsStandard:
+		return "K"
+	default:
+		return "F"
+	}
+}
+
+// CharacterizeTemperature classifies a Fahrenheit temperature into "cold"
+// (<= 40), "chilly" (<= 55), "moderate" (<= 75), "hot" (<= 90), or
+// "very hot".
+func CharacterizeTemperature(fahrenheit int) string {
+	switch {
+	case fahrenheit <= 40:
+		return "cold"
+	case fahrenheit <= 55:
+		return "chilly"
+	case fahrenheit <= 75:
+		return "moderate"
+	case fahrenheit <= 90:
+		return "hot"
+	default:
+		return "very hot"
+	}
+}